@@ -0,0 +1,17 @@
+package signalfx
+
+import "time"
+
+// signalfxConfig holds the resolved provider configuration that every resource's
+// Create/Read/Update/Delete function receives via meta.(*signalfxConfig).
+type signalfxConfig struct {
+	AuthToken    string
+	APIURL       string
+	CustomAppURL string
+
+	// MaxRetries, MinRetryWait, and MaxRetryWait tune how resourceCreate/Read/Update/Delete
+	// back off and retry against SignalFx's 429/5xx responses.
+	MaxRetries   int
+	MinRetryWait time.Duration
+	MaxRetryWait time.Duration
+}
@@ -0,0 +1,12 @@
+package signalfx
+
+// API and web UI paths shared by the resource CRUD functions.
+const (
+	CHART_API_PATH = "v2/chart"
+	CHART_APP_PATH = "#/chart/v2/"
+
+	DASHBOARD_API_PATH = "v2/dashboard"
+
+	DASHBOARD_GROUP_API_PATH = "v2/dashboardgroup"
+	DASHBOARD_GROUP_APP_PATH = "#/dashboard/"
+)
@@ -0,0 +1,150 @@
+package signalfx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+/*
+  dashboardProvisioningDataSource scans a directory of raw SignalFx chart/dashboard JSON
+  exports (the kind the SignalFx UI lets you download) and normalizes each one into the
+  payload shape the matching resource's API expects, so it can be fed straight into a
+  for_each on signalfx_single_value_chart, signalfx_time_chart, signalfx_dashboard, or
+  signalfx_dashboard_group without hand-authoring HCL for every exported chart.
+*/
+func dashboardProvisioningDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: dashboardProvisioningRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Directory containing SignalFx chart/dashboard JSON exports to provision",
+			},
+			"interval_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "When greater than 0, the data source's ID changes once every interval_seconds. path is re-scanned on every read regardless of this value",
+			},
+			"resources": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "One entry per JSON file found under path, ready to drive a resource via for_each",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Path of the source JSON file, relative to path",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource type declared by the file's \"type\" field (single_value_chart, time_chart, dashboard, or dashboard_group)",
+						},
+						"payload": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON payload normalized for the matching resource's create/update API",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dashboardProvisioningRead(d *schema.ResourceData, meta interface{}) error {
+	path := d.Get("path").(string)
+
+	files, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error listing %s: %s", path, err.Error())
+	}
+
+	resources := make([]map[string]interface{}, 0, len(files))
+	for _, file := range files {
+		contents, err := ioutil.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("[DEBUG] SignalFx: Error reading %s: %s", file, err.Error())
+		}
+
+		var raw map[string]interface{}
+		if err := json.Unmarshal(contents, &raw); err != nil {
+			return fmt.Errorf("[DEBUG] SignalFx: Error parsing %s: %s", file, err.Error())
+		}
+
+		chartType, ok := raw["type"].(string)
+		if !ok {
+			return fmt.Errorf("[DEBUG] SignalFx: %s is missing a \"type\" field", file)
+		}
+
+		payload, err := normalizeProvisionedPayload(chartType, raw)
+		if err != nil {
+			return fmt.Errorf("[DEBUG] SignalFx: Error normalizing %s: %s", file, err.Error())
+		}
+
+		resources = append(resources, map[string]interface{}{
+			"file":    filepath.Base(file),
+			"type":    chartType,
+			"payload": string(payload),
+		})
+	}
+
+	if err := d.Set("resources", resources); err != nil {
+		return err
+	}
+
+	if interval, ok := d.GetOk("interval_seconds"); ok && interval.(int) > 0 {
+		bucket := time.Now().Unix() / int64(interval.(int))
+		d.SetId(fmt.Sprintf("%s-%d", path, bucket))
+	} else {
+		d.SetId(path)
+	}
+	return nil
+}
+
+// normalizeProvisionedPayload dispatches a raw JSON export to the same payload shape its
+// matching resource would send to SignalFx, so the output of this data source is a drop-in
+// "payload" attribute rather than a raw, unvalidated export.
+func normalizeProvisionedPayload(chartType string, raw map[string]interface{}) ([]byte, error) {
+	switch chartType {
+	case "single_value_chart":
+		return json.Marshal(map[string]interface{}{
+			"name":        raw["name"],
+			"description": raw["description"],
+			"programText": raw["programText"],
+			"options":     raw["options"],
+		})
+	case "time_chart":
+		return json.Marshal(map[string]interface{}{
+			"name":        raw["name"],
+			"description": raw["description"],
+			"programText": raw["programText"],
+			"options":     raw["options"],
+		})
+	case "dashboard":
+		return json.Marshal(map[string]interface{}{
+			"name":        raw["name"],
+			"description": raw["description"],
+			"charts":      raw["charts"],
+			"groupId":     raw["groupId"],
+		})
+	case "dashboard_group":
+		return json.Marshal(map[string]interface{}{
+			"name":              raw["name"],
+			"description":       raw["description"],
+			"authorizedWriters": raw["authorizedWriters"],
+			"permissions":       raw["permissions"],
+		})
+	default:
+		return nil, fmt.Errorf("unsupported type %q; must be one of \"single_value_chart\", \"time_chart\", \"dashboard\", or \"dashboard_group\"", chartType)
+	}
+}
@@ -0,0 +1,52 @@
+package signalfx
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNormalizeProvisionedPayload(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":              "my chart",
+		"description":       "a chart",
+		"programText":       "data('cpu.load').publish()",
+		"options":           map[string]interface{}{"type": "SingleValue"},
+		"charts":            []interface{}{"chart-1"},
+		"groupId":           "group-1",
+		"authorizedWriters": map[string]interface{}{"teams": []interface{}{}, "users": []interface{}{}},
+		"permissions":       []interface{}{},
+	}
+
+	cases := map[string][]string{
+		"single_value_chart": {"name", "description", "programText", "options"},
+		"time_chart":         {"name", "description", "programText", "options"},
+		"dashboard":          {"name", "description", "charts", "groupId"},
+		"dashboard_group":    {"name", "description", "authorizedWriters", "permissions"},
+	}
+
+	for chartType, wantKeys := range cases {
+		payload, err := normalizeProvisionedPayload(chartType, raw)
+		if err != nil {
+			t.Fatalf("normalizeProvisionedPayload(%q, ...) returned error: %s", chartType, err)
+		}
+
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(payload, &decoded); err != nil {
+			t.Fatalf("normalizeProvisionedPayload(%q, ...) produced invalid JSON: %s", chartType, err)
+		}
+		if len(decoded) != len(wantKeys) {
+			t.Errorf("normalizeProvisionedPayload(%q, ...) = %d keys, want %d (%v)", chartType, len(decoded), len(wantKeys), decoded)
+		}
+		for _, key := range wantKeys {
+			if _, ok := decoded[key]; !ok {
+				t.Errorf("normalizeProvisionedPayload(%q, ...) missing key %q", chartType, key)
+			}
+		}
+	}
+}
+
+func TestNormalizeProvisionedPayloadUnsupportedType(t *testing.T) {
+	if _, err := normalizeProvisionedPayload("unknown_type", map[string]interface{}{}); err == nil {
+		t.Error("normalizeProvisionedPayload(\"unknown_type\", ...) expected an error, got nil")
+	}
+}
@@ -0,0 +1,191 @@
+package signalfx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func isRetryableStatusCode(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+func isSuccessStatusCode(code int) bool {
+	return code >= 200 && code < 300
+}
+
+// jitter randomizes a backoff duration by +/-20% so a burst of throttled resources don't
+// all wake up and retry in lockstep.
+func jitter(d time.Duration) time.Duration {
+	delta := float64(d) * 0.2
+	return d - time.Duration(delta) + time.Duration(rand.Float64()*2*delta)
+}
+
+func buildURL(base string, path string, params map[string]string) (string, error) {
+	parsed, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	parsed.Path = fmt.Sprintf("%s/%s", parsed.Path, path)
+
+	query := parsed.Query()
+	for k, v := range params {
+		query.Set(k, v)
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+func buildAppURL(base string, path string) (string, error) {
+	parsed, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	parsed.Path = fmt.Sprintf("%s/%s", parsed.Path, path)
+	return parsed.String(), nil
+}
+
+/*
+  doRequestWithRetry issues method/url with the given body and retries on 429/5xx with
+  exponential backoff and jitter, honoring a Retry-After header when SignalFx sends one.
+  It's the single chokepoint resourceCreate/Read/Update/Delete all go through, so every
+  resource gets retry behavior for free. The retry tuning comes from config, which is
+  specific to the provider instance that configured it, rather than a shared package
+  global, so two aliased "signalfx" provider blocks in the same plan can't stomp on
+  each other's settings.
+
+  Any non-2xx response is reported as a non-nil error, even once it's determined not to be
+  worth retrying (eg 404/400/403/409) — callers branch on the returned status code (see
+  resourceRead's 404 handling) and rely on a non-nil error meaning the request didn't succeed.
+*/
+func doRequestWithRetry(method string, requestURL string, config *signalfxConfig, payload []byte) ([]byte, int, error) {
+	wait := config.MinRetryWait
+	var lastErr error
+	var lastStatus int
+
+	for attempt := 0; attempt <= config.MaxRetries; attempt++ {
+		req, err := http.NewRequest(method, requestURL, bytes.NewReader(payload))
+		if err != nil {
+			return nil, 0, err
+		}
+		req.Header.Set("X-SF-TOKEN", config.AuthToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			lastErr = err
+		} else {
+			respBody, readErr := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if readErr != nil {
+				return nil, resp.StatusCode, readErr
+			}
+			if isSuccessStatusCode(resp.StatusCode) {
+				return respBody, resp.StatusCode, nil
+			}
+			if !isRetryableStatusCode(resp.StatusCode) {
+				return respBody, resp.StatusCode, fmt.Errorf("SignalFx API returned %d: %s", resp.StatusCode, respBody)
+			}
+			lastStatus = resp.StatusCode
+			lastErr = fmt.Errorf("SignalFx API returned %d: %s", resp.StatusCode, respBody)
+			if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+				if seconds, parseErr := strconv.Atoi(retryAfter); parseErr == nil {
+					wait = time.Duration(seconds) * time.Second
+				}
+			}
+		}
+
+		if attempt == config.MaxRetries {
+			break
+		}
+		time.Sleep(jitter(wait))
+		wait *= 2
+		if wait > config.MaxRetryWait {
+			wait = config.MaxRetryWait
+		}
+	}
+
+	return nil, lastStatus, lastErr
+}
+
+func resourceCreate(url string, config *signalfxConfig, payload []byte, d *schema.ResourceData) error {
+	body, _, err := doRequestWithRetry("POST", url, config, payload)
+	if err != nil {
+		return err
+	}
+
+	var created map[string]interface{}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return err
+	}
+	id, ok := created["id"].(string)
+	if !ok {
+		return fmt.Errorf("[DEBUG] SignalFx: Create response did not include an id: %s", body)
+	}
+	d.SetId(id)
+	if lastUpdated, ok := created["lastUpdated"].(float64); ok {
+		d.Set("last_updated", lastUpdated)
+	}
+	d.Set("synced", true)
+	return nil
+}
+
+func resourceRead(url string, config *signalfxConfig, d *schema.ResourceData) error {
+	body, status, err := doRequestWithRetry("GET", url, config, nil)
+	if err != nil {
+		if status == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	var read map[string]interface{}
+	if err := json.Unmarshal(body, &read); err != nil {
+		return err
+	}
+	if name, ok := read["name"].(string); ok {
+		d.Set("name", name)
+	}
+	if description, ok := read["description"].(string); ok {
+		d.Set("description", description)
+	}
+	if lastUpdated, ok := read["lastUpdated"].(float64); ok {
+		d.Set("last_updated", lastUpdated)
+	}
+	return nil
+}
+
+func resourceUpdate(url string, config *signalfxConfig, payload []byte, d *schema.ResourceData) error {
+	body, _, err := doRequestWithRetry("PUT", url, config, payload)
+	if err != nil {
+		return err
+	}
+
+	var updated map[string]interface{}
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return err
+	}
+	if lastUpdated, ok := updated["lastUpdated"].(float64); ok {
+		d.Set("last_updated", lastUpdated)
+	}
+	d.Set("synced", true)
+	return nil
+}
+
+func resourceDelete(url string, config *signalfxConfig, d *schema.ResourceData) error {
+	_, status, err := doRequestWithRetry("DELETE", url, config, nil)
+	if err != nil && status != http.StatusNotFound {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
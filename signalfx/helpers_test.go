@@ -0,0 +1,37 @@
+package signalfx
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableStatusCode(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusOK:                  false,
+		http.StatusNotFound:            false,
+		http.StatusBadRequest:          false,
+		http.StatusTooManyRequests:     true,
+		http.StatusInternalServerError: true,
+		http.StatusBadGateway:          true,
+		599:                            true,
+	}
+	for code, want := range cases {
+		if got := isRetryableStatusCode(code); got != want {
+			t.Errorf("isRetryableStatusCode(%d) = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestJitterStaysWithinTwentyPercent(t *testing.T) {
+	d := 10 * time.Second
+	lower := d - time.Duration(float64(d)*0.2)
+	upper := d + time.Duration(float64(d)*0.2)
+
+	for i := 0; i < 100; i++ {
+		got := jitter(d)
+		if got < lower || got > upper {
+			t.Fatalf("jitter(%s) = %s, want within [%s, %s]", d, got, lower, upper)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package signalfx
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func Provider() terraform.ResourceProvider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"auth_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SignalFx auth token",
+			},
+			"api_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "https://api.signalfx.com",
+				Description: "API URL to use, if not https://api.signalfx.com",
+			},
+			"custom_app_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "https://app.signalfx.com",
+				Description: "Application URL to use, if not https://app.signalfx.com",
+			},
+			"max_retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     4,
+				Description: "Max number of retries for 429/5xx responses from the SignalFx API",
+			},
+			"min_retry_wait": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Minimum time (in seconds) to wait before the first retry, doubled on each subsequent retry",
+			},
+			"max_retry_wait": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Maximum time (in seconds) to wait between retries",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"signalfx_single_value_chart": singleValueChartResource(),
+			"signalfx_dashboard_group":    dashboardGroupResource(),
+			"signalfx_dashboard_clone":    dashboardCloneResource(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"signalfx_dashboard_provisioning": dashboardProvisioningDataSource(),
+		},
+
+		ConfigureFunc: signalfxConfigure,
+	}
+}
+
+func signalfxConfigure(d *schema.ResourceData) (interface{}, error) {
+	return &signalfxConfig{
+		AuthToken:    d.Get("auth_token").(string),
+		APIURL:       d.Get("api_url").(string),
+		CustomAppURL: d.Get("custom_app_url").(string),
+		MaxRetries:   d.Get("max_retries").(int),
+		MinRetryWait: time.Duration(d.Get("min_retry_wait").(int)) * time.Second,
+		MaxRetryWait: time.Duration(d.Get("max_retry_wait").(int)) * time.Second,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package signalfx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/signalfx/signalfx-go/dashboard_group"
+)
+
+/*
+  signalfx_dashboard_clone wraps the CloneDashboardGroupRequest endpoint: it clones an
+  existing dashboard into a different dashboard group, letting users promote a dashboard
+  built in a personal group into a shared one declaratively. There's nothing to reconcile
+  after the clone runs, so every argument forces a new resource rather than supporting Update.
+*/
+func dashboardCloneResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"source_dashboard_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the dashboard to clone",
+			},
+			"destination_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the dashboard group the clone is created in",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name to give the cloned dashboard (defaults to the source dashboard's name)",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Description to give the cloned dashboard (defaults to the source dashboard's description)",
+			},
+			"synced": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the resource in the provider and SignalFx are identical or not. Used internally for syncing.",
+			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Latest timestamp the resource was updated",
+			},
+		},
+
+		Create: dashboardCloneCreate,
+		Read:   dashboardCloneRead,
+		Delete: dashboardCloneDelete,
+	}
+}
+
+func dashboardCloneCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+
+	payload, err := json.Marshal(dashboard_group.CloneDashboardGroupRequest{
+		DashboardId: d.Get("source_dashboard_id").(string),
+		GroupId:     d.Get("destination_group_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed creating json payload: %s", err.Error())
+	}
+
+	// The clone endpoint lives under the source dashboard, not the destination group: it
+	// clones *this* dashboard, with the destination group (and optional overrides) supplied
+	// in the body. The response is the newly-created dashboard, not a dashboard group.
+	path := fmt.Sprintf("%s/%s/clone", DASHBOARD_API_PATH, d.Get("source_dashboard_id").(string))
+	url, err := buildURL(config.APIURL, path, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
+	}
+
+	body, _, err := doRequestWithRetry("POST", url, config, payload)
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error cloning dashboard: %s", err.Error())
+	}
+
+	var cloned dashboard.Dashboard
+	if err := json.Unmarshal(body, &cloned); err != nil {
+		return err
+	}
+	d.SetId(cloned.Id)
+
+	return dashboardCloneRead(d, meta)
+}
+
+func dashboardCloneRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+	path := fmt.Sprintf("%s/%s", DASHBOARD_API_PATH, d.Id())
+	url, err := buildURL(config.APIURL, path, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
+	}
+
+	return resourceRead(url, config, d)
+}
+
+func dashboardCloneDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+	path := fmt.Sprintf("%s/%s", DASHBOARD_API_PATH, d.Id())
+	url, err := buildURL(config.APIURL, path, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
+	}
+
+	return resourceDelete(url, config, d)
+}
@@ -0,0 +1,47 @@
+package signalfx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDashboardCloneReadNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "not found"}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dashboardCloneResource().Schema, map[string]interface{}{})
+	d.SetId("deleted-id")
+	meta := &signalfxConfig{APIURL: server.URL, MaxRetries: 0}
+
+	if err := dashboardCloneRead(d, meta); err != nil {
+		t.Fatalf("dashboardCloneRead returned error: %s", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("d.Id() = %q, want \"\" after a 404 Read", d.Id())
+	}
+}
+
+func TestDashboardCloneDeleteFailureKeepsId(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"message": "conflict"}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dashboardCloneResource().Schema, map[string]interface{}{})
+	d.SetId("dashboard-id")
+	meta := &signalfxConfig{APIURL: server.URL, MaxRetries: 0}
+
+	if err := dashboardCloneDelete(d, meta); err == nil {
+		t.Fatal("dashboardCloneDelete expected an error on a 409 response, got nil")
+	}
+	if d.Id() != "dashboard-id" {
+		t.Errorf("d.Id() = %q, want \"dashboard-id\" to be kept after a failed Delete", d.Id())
+	}
+}
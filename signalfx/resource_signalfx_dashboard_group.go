@@ -0,0 +1,289 @@
+package signalfx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/signalfx/signalfx-go/dashboard_group"
+)
+
+func dashboardGroupResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the dashboard group",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Description of the dashboard group",
+			},
+			"authorized_writer_teams": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Team IDs that have write access to this dashboard group, in addition to its creator and admins",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"authorized_writer_users": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "User IDs that have write access to this dashboard group, in addition to its creator and admins",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Read/write ACL entries that grant a team, user, or the whole organization access to this dashboard group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "SignalFx-assigned ID of the user, team, or organization this permission applies to",
+						},
+						"principal_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Type of principal, one of \"USER\", \"TEAM\", or \"ORG\"",
+							ValidateFunc: validateDashboardGroupPrincipalType,
+						},
+						"actions": &schema.Schema{
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "Actions granted to this principal, one or both of \"READ\" and \"WRITE\"",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateDashboardGroupAclAction,
+							},
+						},
+					},
+				},
+			},
+			"synced": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the resource in the provider and SignalFx are identical or not. Used internally for syncing.",
+			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Latest timestamp the resource was updated",
+			},
+			"url": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the dashboard group",
+			},
+		},
+
+		Create: dashboardgroupCreate,
+		Read:   dashboardgroupRead,
+		Update: dashboardgroupUpdate,
+		Delete: dashboardgroupDelete,
+	}
+}
+
+func validateDashboardGroupPrincipalType(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "USER", "TEAM", "ORG":
+		return
+	default:
+		errors = append(errors, fmt.Errorf("%s not allowed; must be one of \"USER\", \"TEAM\", or \"ORG\"", value))
+		return
+	}
+}
+
+func validateDashboardGroupAclAction(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "READ", "WRITE":
+		return
+	default:
+		errors = append(errors, fmt.Errorf("%s not allowed; must be one of \"READ\" or \"WRITE\"", value))
+		return
+	}
+}
+
+// getAuthorizedWriters always returns a non-nil *AuthorizedWriters, even when both lists are
+// empty, so getPayloadDashboardGroup can always send the key. Omitting it entirely from the
+// payload when the config clears the last team/user would leave the API's previous value in
+// place instead of clearing it.
+func getAuthorizedWriters(d *schema.ResourceData) *dashboard_group.AuthorizedWriters {
+	return &dashboard_group.AuthorizedWriters{
+		Teams: expandStringListItems(d.Get("authorized_writer_teams").([]interface{})),
+		Users: expandStringListItems(d.Get("authorized_writer_users").([]interface{})),
+	}
+}
+
+func getPermissions(d *schema.ResourceData) []dashboard_group.AclEntry {
+	permissions := make([]dashboard_group.AclEntry, 0)
+	for _, permissionRaw := range d.Get("permissions").(*schema.Set).List() {
+		permission := permissionRaw.(map[string]interface{})
+		actions := make([]string, 0)
+		for _, action := range permission["actions"].(*schema.Set).List() {
+			actions = append(actions, action.(string))
+		}
+		permissions = append(permissions, dashboard_group.AclEntry{
+			PrincipalId:   permission["principal_id"].(string),
+			PrincipalType: permission["principal_type"].(string),
+			Actions:       actions,
+		})
+	}
+	return permissions
+}
+
+func expandStringListItems(items []interface{}) []string {
+	values := make([]string, len(items))
+	for i, item := range items {
+		values[i] = item.(string)
+	}
+	return values
+}
+
+/*
+  Use Resource object to construct json payload in order to create a dashboard group
+*/
+func getPayloadDashboardGroup(d *schema.ResourceData) ([]byte, error) {
+	payload := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+	}
+
+	// Always include authorizedWriters/permissions, even when empty: omitting them when the
+	// config no longer has any entries would leave the API's previous ACL in place instead of
+	// clearing it.
+	payload["authorizedWriters"] = getAuthorizedWriters(d)
+	payload["permissions"] = getPermissions(d)
+
+	return json.Marshal(payload)
+}
+
+func dashboardGroupAPIToState(d *schema.ResourceData, group *dashboard_group.DashboardGroup) error {
+	if err := d.Set("name", group.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", group.Description); err != nil {
+		return err
+	}
+	if err := d.Set("last_updated", group.LastUpdated); err != nil {
+		return err
+	}
+
+	if group.AuthorizedWriters != nil {
+		if err := d.Set("authorized_writer_teams", group.AuthorizedWriters.Teams); err != nil {
+			return err
+		}
+		if err := d.Set("authorized_writer_users", group.AuthorizedWriters.Users); err != nil {
+			return err
+		}
+	} else {
+		d.Set("authorized_writer_teams", []string{})
+		d.Set("authorized_writer_users", []string{})
+	}
+
+	permissions := make([]map[string]interface{}, len(group.Permissions))
+	for i, permission := range group.Permissions {
+		permissions[i] = map[string]interface{}{
+			"principal_id":   permission.PrincipalId,
+			"principal_type": permission.PrincipalType,
+			"actions":        permission.Actions,
+		}
+	}
+	return d.Set("permissions", permissions)
+}
+
+/*
+  getDashboardGroup fetches the full, typed dashboard group object in one request so Read
+  can reconcile both the flat fields (name, description) and the nested authorizedWriters/
+  permissions ACL fields from a single response. It returns (nil, nil) on a 404 so Read can
+  drop the resource from state the same way every other resource does.
+*/
+func getDashboardGroup(url string, config *signalfxConfig) (*dashboard_group.DashboardGroup, error) {
+	body, status, err := doRequestWithRetry("GET", url, config, nil)
+	if err != nil {
+		if status == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	group := &dashboard_group.DashboardGroup{}
+	if err := json.Unmarshal(body, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func dashboardgroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+	payload, err := getPayloadDashboardGroup(d)
+	if err != nil {
+		return fmt.Errorf("Failed creating json payload: %s", err.Error())
+	}
+	url, err := buildURL(config.APIURL, DASHBOARD_GROUP_API_PATH, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
+	}
+
+	err = resourceCreate(url, config, payload, d)
+	if err != nil {
+		return err
+	}
+	appURL, err := buildAppURL(config.CustomAppURL, DASHBOARD_GROUP_APP_PATH+d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("url", appURL)
+	return nil
+}
+
+func dashboardgroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+	path := fmt.Sprintf("%s/%s", DASHBOARD_GROUP_API_PATH, d.Id())
+	url, err := buildURL(config.APIURL, path, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
+	}
+
+	group, err := getDashboardGroup(url, config)
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		d.SetId("")
+		return nil
+	}
+	return dashboardGroupAPIToState(d, group)
+}
+
+func dashboardgroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+	payload, err := getPayloadDashboardGroup(d)
+	if err != nil {
+		return fmt.Errorf("Failed creating json payload: %s", err.Error())
+	}
+	path := fmt.Sprintf("%s/%s", DASHBOARD_GROUP_API_PATH, d.Id())
+	url, err := buildURL(config.APIURL, path, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
+	}
+
+	return resourceUpdate(url, config, payload, d)
+}
+
+func dashboardgroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*signalfxConfig)
+	path := fmt.Sprintf("%s/%s", DASHBOARD_GROUP_API_PATH, d.Id())
+	url, err := buildURL(config.APIURL, path, map[string]string{})
+	if err != nil {
+		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
+	}
+
+	return resourceDelete(url, config, d)
+}
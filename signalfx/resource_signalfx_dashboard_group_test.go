@@ -0,0 +1,83 @@
+package signalfx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/signalfx/signalfx-go/dashboard_group"
+)
+
+func TestGetDashboardGroupNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "not found"}`))
+	}))
+	defer server.Close()
+
+	config := &signalfxConfig{APIURL: server.URL, MaxRetries: 0}
+	url, err := buildURL(config.APIURL, "v2/dashboardgroup/deleted-id", map[string]string{})
+	if err != nil {
+		t.Fatalf("buildURL returned error: %s", err)
+	}
+
+	group, err := getDashboardGroup(url, config)
+	if err != nil {
+		t.Fatalf("getDashboardGroup returned error: %s", err)
+	}
+	if group != nil {
+		t.Fatalf("getDashboardGroup() = %+v, want nil on 404", group)
+	}
+}
+
+func TestGetDashboardGroupSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "abc123", "name": "my group", "description": "a group"}`))
+	}))
+	defer server.Close()
+
+	config := &signalfxConfig{APIURL: server.URL, MaxRetries: 0}
+	url, err := buildURL(config.APIURL, "v2/dashboardgroup/abc123", map[string]string{})
+	if err != nil {
+		t.Fatalf("buildURL returned error: %s", err)
+	}
+
+	group, err := getDashboardGroup(url, config)
+	if err != nil {
+		t.Fatalf("getDashboardGroup returned error: %s", err)
+	}
+	if group == nil || group.Name != "my group" {
+		t.Fatalf("getDashboardGroup() = %+v, want a group named \"my group\"", group)
+	}
+}
+
+func TestDashboardGroupAPIToState(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dashboardGroupResource().Schema, map[string]interface{}{})
+
+	group := &dashboard_group.DashboardGroup{
+		Id:          "abc123",
+		Name:        "my group",
+		Description: "a group",
+		LastUpdated: 12345,
+		AuthorizedWriters: &dashboard_group.AuthorizedWriters{
+			Teams: []string{"team-1"},
+			Users: []string{},
+		},
+	}
+
+	if err := dashboardGroupAPIToState(d, group); err != nil {
+		t.Fatalf("dashboardGroupAPIToState returned error: %s", err)
+	}
+	if got := d.Get("name").(string); got != "my group" {
+		t.Errorf("name = %q, want %q", got, "my group")
+	}
+	if got := d.Get("last_updated").(float64); got != 12345 {
+		t.Errorf("last_updated = %v, want %v", got, 12345)
+	}
+	teams := d.Get("authorized_writer_teams").([]interface{})
+	if len(teams) != 1 || teams[0].(string) != "team-1" {
+		t.Errorf("authorized_writer_teams = %v, want [\"team-1\"]", teams)
+	}
+}
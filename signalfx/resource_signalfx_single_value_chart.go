@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -109,6 +111,37 @@ func singleValueChartResource() *schema.Resource {
 					},
 				},
 			},
+			"threshold": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Single threshold band, translated into a colorScale2 entry alongside any color_scale blocks. Only sent when color_by is set to \"Scale\"; otherwise thresholds are ignored",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operator": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Comparison operator for this threshold. Must be one of \"E\", \"GE\", \"GT\", \"LE\", \"LT\"",
+							ValidateFunc: validateThresholdOperator,
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: "The value this threshold compares against",
+						},
+						"color": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The color to use. Accepts a hex color (eg \"#ff0000\") or one of the named colors also accepted by color_scale",
+							ValidateFunc: validateHeatmapChartColor,
+						},
+						"label": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Label to show for this threshold (Optional)",
+						},
+					},
+				},
+			},
 			"viz_options": &schema.Schema{
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -167,9 +200,100 @@ func singleValueChartResource() *schema.Resource {
 		Read:   singlevaluechartRead,
 		Update: singlevaluechartUpdate,
 		Delete: singlevaluechartDelete,
+
+		CustomizeDiff: validateSingleValueChartThresholds,
+	}
+}
+
+var heatmapChartColorNames = map[string]bool{
+	"gray": true, "blue": true, "navy": true, "orange": true, "yellow": true,
+	"magenta": true, "purple": true, "violet": true, "lilac": true, "green": true, "aquamarine": true,
+}
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// validateHeatmapChartColor accepts either one of the 11 named palette colors or a hex color
+// (eg "#ff0000"), so users matching a corporate palette aren't limited to the fixed names.
+func validateHeatmapChartColor(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	if heatmapChartColorNames[value] || hexColorPattern.MatchString(value) {
+		return
+	}
+	errors = append(errors, fmt.Errorf("%s not allowed; must be a hex color (eg \"#ff0000\") or one of \"gray\", \"blue\", \"navy\", \"orange\", \"yellow\", \"magenta\", \"purple\", \"violet\", \"lilac\", \"green\", \"aquamarine\"", value))
+	return
+}
+
+func validateThresholdOperator(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	switch value {
+	case "E", "GE", "GT", "LE", "LT":
+		return
+	default:
+		errors = append(errors, fmt.Errorf("%s not allowed; must be one of \"E\", \"GE\", \"GT\", \"LE\", or \"LT\"", value))
+		return
 	}
 }
 
+/*
+  Each threshold's operator+value describes a range extending to +/-Inf (or, for "E", a
+  single point), plus whether its lower/upper bound includes the boundary value itself.
+  Sorting those ranges by lower bound and checking neighbours catches any two thresholds
+  that would claim the same part of the value axis, including ones that only touch at a
+  shared inclusive boundary (eg "LE 10" and "GE 10" both match exactly 10).
+*/
+func thresholdRange(operator string, value float64) (lower float64, upper float64, lowerInclusive bool, upperInclusive bool) {
+	switch operator {
+	case "GT":
+		return value, math.Inf(1), false, true
+	case "GE":
+		return value, math.Inf(1), true, true
+	case "LT":
+		return math.Inf(-1), value, true, false
+	case "LE":
+		return math.Inf(-1), value, true, true
+	default: // "E"
+		return value, value, true, true
+	}
+}
+
+type thresholdValueRange struct {
+	lower, upper                   float64
+	lowerInclusive, upperInclusive bool
+}
+
+// validateThresholdRangesDontOverlap is the pure half of validateSingleValueChartThresholds:
+// given the already-extracted ranges, sort by lower bound and check neighbours for overlap.
+// Split out from the *schema.ResourceDiff-handling code below so the overlap math itself can
+// be unit tested without constructing a ResourceDiff.
+func validateThresholdRangesDontOverlap(ranges []thresholdValueRange) error {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lower < ranges[j].lower })
+	for i := 1; i < len(ranges); i++ {
+		prev, curr := ranges[i-1], ranges[i]
+		overlaps := curr.lower < prev.upper || (curr.lower == prev.upper && curr.lowerInclusive && prev.upperInclusive)
+		if overlaps {
+			return fmt.Errorf("threshold ranges must not overlap, but a range starting at %v overlaps a preceding range ending at %v", curr.lower, prev.upper)
+		}
+	}
+	return nil
+}
+
+func validateSingleValueChartThresholds(diff *schema.ResourceDiff, meta interface{}) error {
+	raw, ok := diff.GetOk("threshold")
+	if !ok {
+		return nil
+	}
+
+	thresholds := raw.(*schema.Set).List()
+	ranges := make([]thresholdValueRange, len(thresholds))
+	for i, t := range thresholds {
+		threshold := t.(map[string]interface{})
+		lower, upper, lowerInclusive, upperInclusive := thresholdRange(threshold["operator"].(string), threshold["value"].(float64))
+		ranges[i] = thresholdValueRange{lower: lower, upper: upper, lowerInclusive: lowerInclusive, upperInclusive: upperInclusive}
+	}
+
+	return validateThresholdRangesDontOverlap(ranges)
+}
+
 /*
   Use Resource object to construct json payload in order to create a single value chart
 */
@@ -191,6 +315,41 @@ func getPayloadSingleValueChart(d *schema.ResourceData) ([]byte, error) {
 	return json.Marshal(payload)
 }
 
+/*
+  Translates the "threshold" blocks (operator+value+color) into colorScale2 entries, the
+  same shape getColorScaleOptions produces from "color_scale" blocks, so the two can be
+  combined into a single colorScale2 list.
+*/
+func getThresholdColorScaleOptions(d *schema.ResourceData) []map[string]interface{} {
+	thresholds := d.Get("threshold").(*schema.Set).List()
+	colorScale := make([]map[string]interface{}, len(thresholds))
+	for i, t := range thresholds {
+		threshold := t.(map[string]interface{})
+		entry := map[string]interface{}{
+			"color": threshold["color"].(string),
+		}
+		value := threshold["value"].(float64)
+		switch threshold["operator"].(string) {
+		case "GT":
+			entry["gt"] = value
+		case "GE":
+			entry["gte"] = value
+		case "LT":
+			entry["lt"] = value
+		case "LE":
+			entry["lte"] = value
+		case "E":
+			entry["gte"] = value
+			entry["lte"] = value
+		}
+		if label, ok := threshold["label"].(string); ok && label != "" {
+			entry["label"] = label
+		}
+		colorScale[i] = entry
+	}
+	return colorScale
+}
+
 func getSingleValueChartOptions(d *schema.ResourceData) map[string]interface{} {
 	viz := make(map[string]interface{})
 	viz["type"] = "SingleValue"
@@ -199,7 +358,9 @@ func getSingleValueChartOptions(d *schema.ResourceData) map[string]interface{} {
 	}
 	if val, ok := d.GetOk("color_by"); ok {
 		if val == "Scale" {
-			if colorScaleOptions := getColorScaleOptions(d); len(colorScaleOptions) > 0 {
+			colorScaleOptions := getColorScaleOptions(d)
+			colorScaleOptions = append(colorScaleOptions, getThresholdColorScaleOptions(d)...)
+			if len(colorScaleOptions) > 0 {
 				viz["colorBy"] = "Scale"
 				viz["colorScale2"] = colorScaleOptions
 			}
@@ -243,7 +404,7 @@ func singlevaluechartCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
 	}
 
-	err = resourceCreate(url, config.AuthToken, payload, d)
+	err = resourceCreate(url, config, payload, d)
 	if err != nil {
 		return err
 	}
@@ -264,7 +425,7 @@ func singlevaluechartRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
 	}
 
-	return resourceRead(url, config.AuthToken, d)
+	return resourceRead(url, config, d)
 }
 
 func singlevaluechartUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -279,7 +440,7 @@ func singlevaluechartUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
 	}
 
-	return resourceUpdate(url, config.AuthToken, payload, d)
+	return resourceUpdate(url, config, payload, d)
 }
 
 func singlevaluechartDelete(d *schema.ResourceData, meta interface{}) error {
@@ -290,5 +451,5 @@ func singlevaluechartDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("[DEBUG] SignalFx: Error constructing API URL: %s", err.Error())
 	}
 
-	return resourceDelete(url, config.AuthToken, d)
+	return resourceDelete(url, config, d)
 }
@@ -0,0 +1,47 @@
+package signalfx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestSinglevaluechartReadNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "not found"}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, singleValueChartResource().Schema, map[string]interface{}{})
+	d.SetId("deleted-id")
+	meta := &signalfxConfig{APIURL: server.URL, MaxRetries: 0}
+
+	if err := singlevaluechartRead(d, meta); err != nil {
+		t.Fatalf("singlevaluechartRead returned error: %s", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("d.Id() = %q, want \"\" after a 404 Read", d.Id())
+	}
+}
+
+func TestSinglevaluechartDeleteFailureKeepsId(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "forbidden"}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, singleValueChartResource().Schema, map[string]interface{}{})
+	d.SetId("chart-id")
+	meta := &signalfxConfig{APIURL: server.URL, MaxRetries: 0}
+
+	if err := singlevaluechartDelete(d, meta); err == nil {
+		t.Fatal("singlevaluechartDelete expected an error on a 403 response, got nil")
+	}
+	if d.Id() != "chart-id" {
+		t.Errorf("d.Id() = %q, want \"chart-id\" to be kept after a failed Delete", d.Id())
+	}
+}
@@ -0,0 +1,80 @@
+package signalfx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThresholdRange(t *testing.T) {
+	cases := []struct {
+		operator                        string
+		lower, upper                    float64
+		lowerInclusive, upperInclusive bool
+	}{
+		{"GT", 10, math.Inf(1), false, true},
+		{"GE", 10, math.Inf(1), true, true},
+		{"LT", math.Inf(-1), 10, true, false},
+		{"LE", math.Inf(-1), 10, true, true},
+		{"E", 10, 10, true, true},
+	}
+
+	for _, c := range cases {
+		lower, upper, lowerInclusive, upperInclusive := thresholdRange(c.operator, 10)
+		if lower != c.lower || upper != c.upper || lowerInclusive != c.lowerInclusive || upperInclusive != c.upperInclusive {
+			t.Errorf("thresholdRange(%q, 10) = (%v, %v, %v, %v), want (%v, %v, %v, %v)",
+				c.operator, lower, upper, lowerInclusive, upperInclusive,
+				c.lower, c.upper, c.lowerInclusive, c.upperInclusive)
+		}
+	}
+}
+
+func rangeFor(operator string, value float64) thresholdValueRange {
+	lower, upper, lowerInclusive, upperInclusive := thresholdRange(operator, value)
+	return thresholdValueRange{lower: lower, upper: upper, lowerInclusive: lowerInclusive, upperInclusive: upperInclusive}
+}
+
+func TestValidateThresholdRangesDontOverlap(t *testing.T) {
+	cases := []struct {
+		name        string
+		ranges      []thresholdValueRange
+		wantErr bool
+	}{
+		{
+			name:    "disjoint GT and LT",
+			ranges:  []thresholdValueRange{rangeFor("GT", 10), rangeFor("LT", 10)},
+			wantErr: false,
+		},
+		{
+			name:    "disjoint GE and LT",
+			ranges:  []thresholdValueRange{rangeFor("GE", 10), rangeFor("LT", 10)},
+			wantErr: false,
+		},
+		{
+			name:    "disjoint GT and LE",
+			ranges:  []thresholdValueRange{rangeFor("GT", 10), rangeFor("LE", 10)},
+			wantErr: false,
+		},
+		{
+			name:    "touching inclusive boundaries overlap",
+			ranges:  []thresholdValueRange{rangeFor("LE", 10), rangeFor("GE", 10)},
+			wantErr: true,
+		},
+		{
+			name:    "two equality thresholds at the same value overlap",
+			ranges:  []thresholdValueRange{rangeFor("E", 10), rangeFor("E", 10)},
+			wantErr: true,
+		},
+		{
+			name:    "clearly overlapping ranges",
+			ranges:  []thresholdValueRange{rangeFor("GE", 5), rangeFor("LE", 15)},
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		err := validateThresholdRangesDontOverlap(c.ranges)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: validateThresholdRangesDontOverlap() error = %v, wantErr %v", c.name, err, c.wantErr)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+/*
+ * Dashboards API
+ *
+ * Dashboards display a collection of charts arranged in a grid, so related metrics can be
+ * viewed side by side.
+ *
+ * API version: 3.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package dashboard
+
+type Dashboard struct {
+	// The SignalFx-assigned ID of the dashboard.
+	Id string `json:"id,omitempty"`
+	// The dashboard's name.
+	Name string `json:"name,omitempty"`
+	// The dashboard's description.
+	Description string `json:"description,omitempty"`
+	// The SignalFx-assigned ID of the dashboard group this dashboard belongs to.
+	GroupId string `json:"groupId,omitempty"`
+	// Latest time the dashboard was updated, in Unix time UTC-relative milliseconds.
+	LastUpdated float64 `json:"lastUpdated,omitempty"`
+}
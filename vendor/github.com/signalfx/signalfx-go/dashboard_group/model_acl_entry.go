@@ -0,0 +1,19 @@
+/*
+ * Dashboard Groups API
+ *
+ * Dashboard groups let you collect dashboards with common characteristics in one place in the web UI, so you can view them together or in sequence.
+ *
+ * API version: 3.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package dashboard_group
+
+type AclEntry struct {
+	// The SignalFx-assigned ID of the user, team, or organization that this entry grants access to.
+	PrincipalId string `json:"principalId"`
+	// The type of principal that `principalId` refers to. One of `USER`, `TEAM`, or `ORG`.
+	PrincipalType string `json:"principalType"`
+	// The actions this principal is authorized to perform on the dashboard group. One or both of `READ` and `WRITE`.
+	Actions []string `json:"actions,omitempty"`
+}
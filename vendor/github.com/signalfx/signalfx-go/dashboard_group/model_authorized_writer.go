@@ -0,0 +1,21 @@
+/*
+ * Dashboard Groups API
+ *
+ * Dashboard groups let you collect dashboards with common characteristics in one place in the web UI, so you can view them together or in sequence.
+ *
+ * API version: 3.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package dashboard_group
+
+// AuthorizedWriters lists the teams and users who, in addition to the dashboard group's
+// creator and organization admins, are allowed to modify it when write permissions are enforced.
+type AuthorizedWriters struct {
+	// SignalFx-assigned IDs of the teams authorized to write to this dashboard group. Sent as
+	// an empty list (rather than omitted) to let callers clear a previously-set list.
+	Teams []string `json:"teams"`
+	// SignalFx-assigned IDs of the users authorized to write to this dashboard group. Sent as
+	// an empty list (rather than omitted) to let callers clear a previously-set list.
+	Users []string `json:"users"`
+}
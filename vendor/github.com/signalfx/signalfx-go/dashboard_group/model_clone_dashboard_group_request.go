@@ -0,0 +1,23 @@
+/*
+ * Dashboard Groups API
+ *
+ * Dashboard groups let you collect dashboards with common characteristics in one place in the web UI, so you can view them together or in sequence.
+ *
+ * API version: 3.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package dashboard_group
+
+// CloneDashboardGroupRequest clones a single dashboard from one dashboard group into another,
+// optionally overriding its name and description on the copy.
+type CloneDashboardGroupRequest struct {
+	// The SignalFx-assigned ID of the dashboard to clone.
+	DashboardId string `json:"dashboardId"`
+	// The SignalFx-assigned ID of the dashboard group the clone should belong to.
+	GroupId string `json:"groupId"`
+	// Name to give the cloned dashboard. Defaults to the source dashboard's name.
+	Name string `json:"name,omitempty"`
+	// Description to give the cloned dashboard. Defaults to the source dashboard's description.
+	Description string `json:"description,omitempty"`
+}
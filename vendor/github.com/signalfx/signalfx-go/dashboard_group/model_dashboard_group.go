@@ -0,0 +1,26 @@
+/*
+ * Dashboard Groups API
+ *
+ * Dashboard groups let you collect dashboards with common characteristics in one place in the web UI, so you can view them together or in sequence.
+ *
+ * API version: 3.0.0
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package dashboard_group
+
+type DashboardGroup struct {
+	// The SignalFx-assigned ID of the dashboard group.
+	Id string `json:"id,omitempty"`
+	// The dashboard group's name.
+	Name string `json:"name,omitempty"`
+	// The dashboard group's description.
+	Description string `json:"description,omitempty"`
+	// The dashboard group's creator and organization admins can always write to it. AuthorizedWriters
+	// grants write access to additional teams and users when write permissions are enforced.
+	AuthorizedWriters *AuthorizedWriters `json:"authorizedWriters,omitempty"`
+	// Read/write ACL entries that grant teams, users, or the organization access to this dashboard group.
+	Permissions []AclEntry `json:"permissions,omitempty"`
+	// Latest time the dashboard group was updated, in Unix time UTC-relative milliseconds.
+	LastUpdated float64 `json:"lastUpdated,omitempty"`
+}